@@ -0,0 +1,102 @@
+package vector
+
+import (
+	"iter"
+	"slices"
+)
+
+/*
+Appends every value produced by it to the end of the Vector.
+*/
+func (v *Vector[T]) Extend(it iter.Seq[T]) {
+	v.ExtendFromSlice(slices.Collect(it))
+}
+
+/*
+Appends every value in s to the end of the Vector. Computes the required capacity up front and performs
+at most one allocation, regardless of len(s).
+*/
+func (v *Vector[T]) ExtendFromSlice(s []T) {
+	if len(s) == 0 {
+		return
+	}
+
+	v.ensureCapacity(v.len + len(s))
+	copy(v.data[v.len:], s)
+	v.len += len(s)
+}
+
+/*
+Appends the contents of other to the end of the Vector. other is left unchanged.
+*/
+func (v *Vector[T]) Append(other *Vector[T]) {
+	v.ExtendFromSlice(other.Data())
+}
+
+/*
+Resizes the Vector to newLen. If newLen is greater than the current length, the new elements are set to
+fill. If newLen is less than the current length, the Vector is truncated.
+*/
+func (v *Vector[T]) Resize(newLen int, fill T) {
+	if newLen <= v.len {
+		v.len = newLen
+		return
+	}
+
+	v.ensureCapacity(newLen)
+	for i := v.len; i < newLen; i++ {
+		v.data[i] = fill
+	}
+	v.len = newLen
+}
+
+/*
+Resizes the Vector to newLen. If newLen is greater than the current length, f is called once per new
+element to produce its value. If newLen is less than the current length, the Vector is truncated.
+*/
+func (v *Vector[T]) ResizeWith(newLen int, f func() T) {
+	if newLen <= v.len {
+		v.len = newLen
+		return
+	}
+
+	v.ensureCapacity(newLen)
+	for i := v.len; i < newLen; i++ {
+		v.data[i] = f()
+	}
+	v.len = newLen
+}
+
+/*
+Replaces the elements in [start, end) with replacement, shifting the tail to accommodate any difference
+in length exactly once. Returns the removed elements. Returns an error if the range is out of bounds.
+*/
+func (v *Vector[T]) Splice(start, end int, replacement []T) ([]T, error) {
+	if err := v.rangeCheck(start, end); err != nil {
+		return nil, err
+	}
+
+	removed := make([]T, end-start)
+	copy(removed, v.data[start:end])
+
+	oldLen := v.len
+	tailLen := oldLen - end
+	newLen := start + len(replacement) + tailLen
+
+	if newLen > v.capacity {
+		newData := make([]T, newLen)
+		copy(newData, v.data[:start])
+		copy(newData[start:], replacement)
+		copy(newData[start+len(replacement):], v.data[end:oldLen])
+
+		v.data = newData
+		v.capacity = newLen
+	} else {
+		copy(v.data[start+len(replacement):newLen], v.data[end:oldLen])
+		copy(v.data[start:], replacement)
+	}
+
+	v.len = newLen
+
+	return removed, nil
+}
@@ -0,0 +1,90 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendFromSlice(t *testing.T) {
+	v := Empty[int](2)
+
+	v.ExtendFromSlice([]int{1, 2, 3, 4, 5})
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, v.Data())
+	assert.Equal(t, 5, v.Capacity())
+}
+
+func TestExtendFromSliceSingleAllocation(t *testing.T) {
+	v := Empty[int](1)
+
+	large := make([]int, 10_000)
+	for i := range large {
+		large[i] = i
+	}
+
+	v.ExtendFromSlice(large)
+
+	assert.Equal(t, 10_000, v.Len())
+	assert.Equal(t, 10_000, v.Capacity())
+}
+
+func TestExtend(t *testing.T) {
+	v := Empty[int](2)
+	v.PushBack(1)
+
+	src := FromSlice([]int{2, 3, 4}, 5)
+	v.Extend(src.Values())
+
+	assert.Equal(t, []int{1, 2, 3, 4}, v.Data())
+}
+
+func TestAppend(t *testing.T) {
+	v := FromSlice([]int{1, 2}, 2)
+	other := FromSlice([]int{3, 4}, 2)
+
+	v.Append(other)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, v.Data())
+	assert.Equal(t, []int{3, 4}, other.Data())
+}
+
+func TestResize(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3}, 2)
+
+	v.Resize(5, 9)
+	assert.Equal(t, []int{1, 2, 3, 9, 9}, v.Data())
+
+	v.Resize(1, 0)
+	assert.Equal(t, []int{1}, v.Data())
+}
+
+func TestResizeWith(t *testing.T) {
+	v := Empty[int](2)
+
+	next := 1
+	v.ResizeWith(3, func() int {
+		val := next
+		next++
+		return val
+	})
+
+	assert.Equal(t, []int{1, 2, 3}, v.Data())
+}
+
+func TestSplice(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3, 4, 5}, 5)
+
+	removed, err := v.Splice(1, 3, []int{9, 9, 9, 9})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, 3}, removed)
+	assert.Equal(t, []int{1, 9, 9, 9, 9, 4, 5}, v.Data())
+
+	removed, err = v.Splice(0, 4, []int{0})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 9, 9, 9}, removed)
+	assert.Equal(t, []int{0, 9, 4, 5}, v.Data())
+
+	_, err = v.Splice(-1, 2, nil)
+	assert.EqualError(t, err, OutOfBounds)
+}
@@ -0,0 +1,74 @@
+package vector
+
+import "errors"
+
+/*
+Ensures the Vector has capacity for at least `additional` more elements, growing via the Vector's
+GrowthStrategy (so repeated Reserve calls don't degrade to O(n) amortized growth). Returns an error if
+additional <= 0.
+*/
+func (v *Vector[T]) Reserve(additional int) error {
+	if additional <= 0 {
+		return errors.New(CannotAddAmount)
+	}
+
+	required := v.len + additional
+	if v.capacity >= required {
+		return nil
+	}
+
+	newCapacity := v.capacity
+	for newCapacity < required {
+		newCapacity = v.nextCapacity(newCapacity)
+	}
+
+	return v.AddCapacity(newCapacity - v.capacity)
+}
+
+/*
+Grows the Vector to exactly `v.Len() + additional` capacity, without any extra over-allocation from the
+growth strategy. Does nothing if the capacity is already at least that. Returns an error if additional <= 0.
+*/
+func (v *Vector[T]) ReserveExact(additional int) error {
+	if additional <= 0 {
+		return errors.New(CannotAddAmount)
+	}
+
+	required := v.len + additional
+	if v.capacity >= required {
+		return nil
+	}
+
+	return v.AddCapacity(required - v.capacity)
+}
+
+/*
+Shrinks the Vector's capacity down to its current length.
+*/
+func (v *Vector[T]) ShrinkToFit() {
+	v.shrinkTo(v.len)
+}
+
+/*
+Shrinks the Vector's capacity down to max(v.Len(), minCap).
+*/
+func (v *Vector[T]) ShrinkTo(minCap int) {
+	target := minCap
+	if v.len > target {
+		target = v.len
+	}
+
+	v.shrinkTo(target)
+}
+
+func (v *Vector[T]) shrinkTo(target int) {
+	if target >= v.capacity {
+		return
+	}
+
+	newData := make([]T, target)
+	copy(newData, v.data[:v.len])
+
+	v.data = newData
+	v.capacity = target
+}
@@ -0,0 +1,72 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddCapacityAllocatesOffLocalCapacity(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3}, 5)
+
+	assert.Nil(t, v.AddCapacity(2))
+	assert.Equal(t, 5, v.Capacity())
+	assert.Equal(t, []int{1, 2, 3}, v.Data())
+
+	// a second call must grow from the already-updated capacity, not double-count it
+	assert.Nil(t, v.AddCapacity(1))
+	assert.Equal(t, 6, v.Capacity())
+}
+
+func TestReserve(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3}, 2)
+	v.SetGrowthStrategy(GeometricGrowth{Factor: 2, Min: 1})
+
+	assert.Nil(t, v.Reserve(5))
+	assert.GreaterOrEqual(t, v.Capacity(), 8)
+	assert.Equal(t, []int{1, 2, 3}, v.Data())
+
+	capBefore := v.Capacity()
+	assert.Nil(t, v.Reserve(1))
+	assert.Equal(t, capBefore, v.Capacity())
+
+	assert.EqualError(t, v.Reserve(0), CannotAddAmount)
+}
+
+func TestReserveWithStaleGrowthStrategyPanicsInsteadOfHanging(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3}, 2)
+	v.SetGrowthStrategy(GeometricGrowth{Factor: 1, Min: 0})
+
+	assert.PanicsWithValue(t, InvalidGrowthStrategy, func() {
+		_ = v.Reserve(5)
+	})
+}
+
+func TestReserveExact(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3}, 2)
+
+	assert.Nil(t, v.ReserveExact(4))
+	assert.Equal(t, 7, v.Capacity())
+
+	assert.Nil(t, v.ReserveExact(1))
+	assert.Equal(t, 7, v.Capacity())
+
+	assert.EqualError(t, v.ReserveExact(-1), CannotAddAmount)
+}
+
+func TestShrinkToFitAndShrinkTo(t *testing.T) {
+	v := EmptyWithCapacity[int](10, 2)
+	v.ExtendFromSlice([]int{1, 2, 3})
+
+	v.ShrinkTo(8)
+	assert.Equal(t, 8, v.Capacity())
+
+	// shrinking below the current length is clamped to the length
+	v.ShrinkTo(1)
+	assert.Equal(t, 3, v.Capacity())
+
+	v.ExtendFromSlice([]int{4, 5})
+	v.ShrinkToFit()
+	assert.Equal(t, 5, v.Capacity())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, v.Data())
+}
@@ -0,0 +1,268 @@
+package vector
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+const InvalidEncoding = "invalid encoded vector data"
+
+// maxDecodedCapacity is a generous sanity ceiling on an untrusted capacity header. MarshalBinary only
+// writes `length` elements to the wire (capacity is metadata, and can legitimately be much larger than
+// what's encoded — e.g. a Vector built with EmptyWithCapacity or grown via Reserve), so capacity can't be
+// validated against the byte count of the payload. This just stops an absurd attacker-supplied value
+// (e.g. 1<<40) from reaching `make([]T, capacity)`.
+const maxDecodedCapacity = 1 << 32
+
+/*
+DefaultAllocAmount is the allocAmount a Vector is given when it is populated via UnmarshalJSON and
+doesn't already have one set (e.g. the zero value, or a struct field that was never constructed
+through FromSlice/Empty/EmptyWithCapacity).
+*/
+var DefaultAllocAmount = 8
+
+/*
+Encodes and decodes a single element of a Vector for binary marshaling.
+*/
+type Codec[T any] interface {
+	Encode(w io.Writer, val T) error
+	Decode(r io.Reader) (T, error)
+}
+
+/*
+Sets the Codec a Vector uses for MarshalBinary/UnmarshalBinary. Defaults to a built-in codec for numeric
+types, or a reflect-based encoding/gob codec otherwise.
+*/
+func WithCodec[T any](codec Codec[T]) Option[T] {
+	return func(v *Vector[T]) {
+		v.codec = codec
+	}
+}
+
+type number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// binaryCodec encodes fixed-width numeric types via encoding/binary.
+type binaryCodec[T number] struct{}
+
+func (binaryCodec[T]) Encode(w io.Writer, val T) error {
+	return binary.Write(w, binary.LittleEndian, val)
+}
+
+func (binaryCodec[T]) Decode(r io.Reader) (T, error) {
+	var val T
+	err := binary.Read(r, binary.LittleEndian, &val)
+	return val, err
+}
+
+// convertingCodec encodes architecture-dependent-width types (int, uint) as a fixed-width wire type W,
+// since binary.Write/binary.Read reject the native types as not fixed-size.
+type convertingCodec[T, W number] struct{}
+
+func (convertingCodec[T, W]) Encode(w io.Writer, val T) error {
+	return binary.Write(w, binary.LittleEndian, W(val))
+}
+
+func (convertingCodec[T, W]) Decode(r io.Reader) (T, error) {
+	var wire W
+	err := binary.Read(r, binary.LittleEndian, &wire)
+	return T(wire), err
+}
+
+// gobCodec is the reflect-based fallback for types with no built-in codec.
+type gobCodec[T any] struct{}
+
+func (gobCodec[T]) Encode(w io.Writer, val T) error {
+	return gob.NewEncoder(w).Encode(val)
+}
+
+func (gobCodec[T]) Decode(r io.Reader) (T, error) {
+	var val T
+	err := gob.NewDecoder(r).Decode(&val)
+	return val, err
+}
+
+func defaultCodec[T any]() Codec[T] {
+	var zero T
+
+	switch any(zero).(type) {
+	case int:
+		return any(convertingCodec[int, int64]{}).(Codec[T])
+	case int8:
+		return any(binaryCodec[int8]{}).(Codec[T])
+	case int16:
+		return any(binaryCodec[int16]{}).(Codec[T])
+	case int32:
+		return any(binaryCodec[int32]{}).(Codec[T])
+	case int64:
+		return any(binaryCodec[int64]{}).(Codec[T])
+	case uint:
+		return any(convertingCodec[uint, uint64]{}).(Codec[T])
+	case uint8:
+		return any(binaryCodec[uint8]{}).(Codec[T])
+	case uint16:
+		return any(binaryCodec[uint16]{}).(Codec[T])
+	case uint32:
+		return any(binaryCodec[uint32]{}).(Codec[T])
+	case uint64:
+		return any(binaryCodec[uint64]{}).(Codec[T])
+	case float32:
+		return any(binaryCodec[float32]{}).(Codec[T])
+	case float64:
+		return any(binaryCodec[float64]{}).(Codec[T])
+	default:
+		return gobCodec[T]{}
+	}
+}
+
+/*
+Encodes the Vector as a little-endian uint64 length, capacity, and allocAmount, followed by each
+element encoded via the Vector's Codec.
+*/
+func (v *Vector[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+
+	var header [24]byte
+	binary.LittleEndian.PutUint64(header[0:8], uint64(v.len))
+	binary.LittleEndian.PutUint64(header[8:16], uint64(v.capacity))
+	binary.LittleEndian.PutUint64(header[16:24], uint64(v.allocAmount))
+	buf.Write(header[:])
+
+	for i := 0; i < v.len; i++ {
+		if err := v.codec.Encode(&buf, v.data[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+/*
+Decodes a Vector from the format written by MarshalBinary. If the Vector has no Codec set (e.g. the zero
+value), it falls back to defaultCodec[T]().
+*/
+func (v *Vector[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < 24 {
+		return errors.New(InvalidEncoding)
+	}
+
+	r := bytes.NewReader(data)
+
+	var header [24]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return err
+	}
+
+	length := int(binary.LittleEndian.Uint64(header[0:8]))
+	capacity := int(binary.LittleEndian.Uint64(header[8:16]))
+	allocAmount := int(binary.LittleEndian.Uint64(header[16:24]))
+
+	// The header is untrusted input. Every element takes at least one byte on the wire, and
+	// MarshalBinary only ever writes `length` of them, so length can never exceed the number of bytes
+	// actually supplied — but capacity is just metadata and can legitimately exceed that (e.g. a Vector
+	// built with EmptyWithCapacity or grown via Reserve). Validate capacity against length and a sane
+	// ceiling instead.
+	remaining := len(data) - 24
+	if length < 0 || length > remaining || capacity < length || capacity > maxDecodedCapacity {
+		return errors.New(InvalidEncoding)
+	}
+
+	codec := v.codec
+	if codec == nil {
+		codec = defaultCodec[T]()
+	}
+
+	newData := make([]T, capacity)
+	for i := 0; i < length; i++ {
+		val, err := codec.Decode(r)
+		if err != nil {
+			return err
+		}
+		newData[i] = val
+	}
+
+	v.data = newData
+	v.len = length
+	v.capacity = capacity
+	v.allocAmount = allocAmount
+	v.codec = codec
+
+	if v.growth == nil {
+		v.growth = FixedGrowth{Amount: allocAmount}
+	}
+
+	return nil
+}
+
+/*
+Marshals the Vector as a plain JSON array, dropping capacity/allocAmount.
+*/
+func (v *Vector[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.data[:v.len])
+}
+
+/*
+Unmarshals a JSON array into the Vector. If the Vector doesn't already have an allocAmount set, it uses
+DefaultAllocAmount. To control the allocAmount explicitly, decode with VectorOf[T] instead. A GrowthStrategy
+or Codec already configured on the Vector (via WithGrowth/WithCodec) is preserved, matching UnmarshalBinary.
+*/
+func (v *Vector[T]) UnmarshalJSON(data []byte) error {
+	var slice []T
+	if err := json.Unmarshal(data, &slice); err != nil {
+		return err
+	}
+
+	allocAmount := v.allocAmount
+	if allocAmount <= 0 {
+		allocAmount = DefaultAllocAmount
+	}
+
+	if v.growth == nil {
+		v.growth = FixedGrowth{Amount: allocAmount}
+	}
+	if v.codec == nil {
+		v.codec = defaultCodec[T]()
+	}
+
+	size := len(slice)
+	v.data = slice
+	v.len = size
+	v.capacity = size
+	v.allocAmount = allocAmount
+
+	return nil
+}
+
+/*
+Decodes JSON arrays into Vectors with a specific allocAmount, for callers who don't want
+DefaultAllocAmount.
+*/
+type VectorDecoder[T any] struct {
+	AllocAmount int
+}
+
+/*
+Returns a VectorDecoder configured with allocAmount.
+*/
+func VectorOf[T any](allocAmount int) *VectorDecoder[T] {
+	return &VectorDecoder[T]{AllocAmount: allocAmount}
+}
+
+/*
+Unmarshals a JSON array into a new Vector using d.AllocAmount.
+*/
+func (d *VectorDecoder[T]) Decode(data []byte) (*Vector[T], error) {
+	var slice []T
+	if err := json.Unmarshal(data, &slice); err != nil {
+		return nil, err
+	}
+
+	return FromSlice(slice, d.AllocAmount), nil
+}
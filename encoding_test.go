@@ -0,0 +1,141 @@
+package vector
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3, 4, 5}, 3)
+
+	data, err := v.MarshalBinary()
+	assert.Nil(t, err)
+
+	var decoded Vector[int]
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+
+	assert.Equal(t, v.Len(), decoded.Len())
+	assert.Equal(t, v.Capacity(), decoded.Capacity())
+	assert.Equal(t, v.AllocAmount(), decoded.AllocAmount())
+	assert.Equal(t, v.Data(), decoded.Data())
+}
+
+func TestBinaryRoundTripGobFallback(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+
+	v := FromSlice([]point{{1, 2}, {3, 4}}, 2)
+
+	data, err := v.MarshalBinary()
+	assert.Nil(t, err)
+
+	var decoded Vector[point]
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+
+	assert.Equal(t, v.Data(), decoded.Data())
+}
+
+func TestUnmarshalBinaryInvalidData(t *testing.T) {
+	var v Vector[int]
+	assert.EqualError(t, v.UnmarshalBinary([]byte{1, 2, 3}), InvalidEncoding)
+}
+
+func TestBinaryRoundTripCapacityLargerThanLength(t *testing.T) {
+	v := EmptyWithCapacity[int8](20, 3)
+	v.PushBack(1)
+	v.PushBack(2)
+
+	data, err := v.MarshalBinary()
+	assert.Nil(t, err)
+
+	var decoded Vector[int8]
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+
+	assert.Equal(t, v.Len(), decoded.Len())
+	assert.Equal(t, v.Capacity(), decoded.Capacity())
+	assert.Equal(t, v.Data(), decoded.Data())
+}
+
+func TestBinaryRoundTripLargeCapacitySmallLength(t *testing.T) {
+	v := EmptyWithCapacity[int](1000, 3)
+	v.PushBack(1)
+	v.PushBack(2)
+
+	data, err := v.MarshalBinary()
+	assert.Nil(t, err)
+
+	var decoded Vector[int]
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+
+	assert.Equal(t, v.Len(), decoded.Len())
+	assert.Equal(t, v.Capacity(), decoded.Capacity())
+	assert.Equal(t, v.Data(), decoded.Data())
+}
+
+func TestUnmarshalBinaryRejectsLengthGreaterThanCapacity(t *testing.T) {
+	header := make([]byte, 24)
+	binary.LittleEndian.PutUint64(header[0:8], 5)
+	binary.LittleEndian.PutUint64(header[8:16], 1)
+	binary.LittleEndian.PutUint64(header[16:24], 2)
+
+	var v Vector[int]
+	assert.EqualError(t, v.UnmarshalBinary(header), InvalidEncoding)
+}
+
+func TestUnmarshalBinaryRejectsLengthLargerThanInput(t *testing.T) {
+	header := make([]byte, 24)
+	binary.LittleEndian.PutUint64(header[0:8], 1<<40)
+	binary.LittleEndian.PutUint64(header[8:16], 1<<40)
+	binary.LittleEndian.PutUint64(header[16:24], 2)
+
+	var v Vector[int]
+	assert.EqualError(t, v.UnmarshalBinary(header), InvalidEncoding)
+}
+
+func TestUnmarshalBinaryRejectsAbsurdCapacityEvenWithSmallLength(t *testing.T) {
+	header := make([]byte, 24)
+	binary.LittleEndian.PutUint64(header[0:8], 0)
+	binary.LittleEndian.PutUint64(header[8:16], 1<<40)
+	binary.LittleEndian.PutUint64(header[16:24], 2)
+
+	var v Vector[int]
+	assert.EqualError(t, v.UnmarshalBinary(header), InvalidEncoding)
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3}, 5)
+
+	data, err := v.MarshalJSON()
+	assert.Nil(t, err)
+	assert.Equal(t, "[1,2,3]", string(data))
+
+	var decoded Vector[int]
+	assert.Nil(t, decoded.UnmarshalJSON(data))
+
+	assert.Equal(t, []int{1, 2, 3}, decoded.Data())
+	assert.Equal(t, DefaultAllocAmount, decoded.AllocAmount())
+}
+
+func TestUnmarshalJSONPreservesGrowthAndCodec(t *testing.T) {
+	growth := GeometricGrowth{Factor: 2, Min: 4}
+	codec := gobCodec[int]{}
+
+	v := Empty[int](5, WithGrowth[int](growth), WithCodec[int](codec))
+
+	assert.Nil(t, v.UnmarshalJSON([]byte("[1,2,3]")))
+
+	assert.Equal(t, []int{1, 2, 3}, v.Data())
+	assert.Equal(t, growth, v.GrowthStrategy())
+	assert.Equal(t, codec, v.codec)
+}
+
+func TestVectorOfDecoder(t *testing.T) {
+	decoded, err := VectorOf[int](2).Decode([]byte("[1,2,3]"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, decoded.Data())
+	assert.Equal(t, 2, decoded.AllocAmount())
+}
@@ -0,0 +1,99 @@
+package vector
+
+import (
+	"errors"
+	"iter"
+)
+
+/*
+Returns an iterator over (index, value) pairs, in order.
+*/
+func (v *Vector[T]) Iter() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < v.len; i++ {
+			if !yield(i, v.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+/*
+Returns an iterator over the values in the Vector, in order.
+*/
+func (v *Vector[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < v.len; i++ {
+			if !yield(v.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+/*
+Returns an iterator that yields the elements in [start, end) and removes them from the Vector as they
+are consumed. If the caller stops ranging before the iterator is exhausted, the remaining elements in
+the range are still removed once the iterator function returns.
+
+Panics if the range is out of bounds.
+*/
+func (v *Vector[T]) Drain(start, end int) iter.Seq[T] {
+	if err := v.rangeCheck(start, end); err != nil {
+		panic(err)
+	}
+
+	return func(yield func(T) bool) {
+		stopped := false
+
+		for i := start; i < end; i++ {
+			if !stopped && !yield(v.data[i]) {
+				stopped = true
+			}
+		}
+
+		copy(v.data[start:], v.data[end:v.len])
+		v.len -= end - start
+	}
+}
+
+/*
+Returns an iterator that walks the Vector once, yielding every element for which pred returns true and
+removing it. Remaining elements are shifted down in the same pass, so a full ExtractIf runs in O(n) time
+rather than O(n*k) for k matches. Modeled on Rust's Vec::extract_if.
+
+If the caller stops ranging early, the scan still finishes compacting the remaining elements before the
+iterator function returns.
+*/
+func (v *Vector[T]) ExtractIf(pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		stopped := false
+		write := 0
+
+		for read := 0; read < v.len; read++ {
+			val := v.data[read]
+
+			if pred(val) {
+				if !stopped && !yield(val) {
+					stopped = true
+				}
+				continue
+			}
+
+			if write != read {
+				v.data[write] = val
+			}
+			write++
+		}
+
+		v.len = write
+	}
+}
+
+func (v *Vector[T]) rangeCheck(start, end int) error {
+	if start < 0 || end > v.len || start > end {
+		return errors.New(OutOfBounds)
+	}
+
+	return nil
+}
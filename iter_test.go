@@ -0,0 +1,73 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterValues(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3}, 5)
+
+	var indices []int
+	var vals []int
+	for i, val := range v.Iter() {
+		indices = append(indices, i)
+		vals = append(vals, val)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []int{1, 2, 3}, vals)
+
+	var valsOnly []int
+	for val := range v.Values() {
+		valsOnly = append(valsOnly, val)
+	}
+	assert.Equal(t, []int{1, 2, 3}, valsOnly)
+
+	// breaking early must not panic or visit further elements
+	count := 0
+	for range v.Iter() {
+		count++
+		if count == 1 {
+			break
+		}
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestDrain(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3, 4, 5}, 5)
+
+	var drained []int
+	for val := range v.Drain(1, 3) {
+		drained = append(drained, val)
+	}
+
+	assert.Equal(t, []int{2, 3}, drained)
+	assert.Equal(t, []int{1, 4, 5}, v.Data())
+}
+
+func TestDrainBreaksEarlyButStillRemoves(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3, 4, 5}, 5)
+
+	for val := range v.Drain(1, 4) {
+		if val == 3 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 5}, v.Data())
+}
+
+func TestExtractIf(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3, 4, 5, 6}, 5)
+
+	var evens []int
+	for val := range v.ExtractIf(func(x int) bool { return x%2 == 0 }) {
+		evens = append(evens, val)
+	}
+
+	assert.Equal(t, []int{2, 4, 6}, evens)
+	assert.Equal(t, []int{1, 3, 5}, v.Data())
+}
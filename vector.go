@@ -5,13 +5,13 @@ package vector
 
 import (
 	"errors"
-	"slices"
 )
 
 const (
-	OutOfBounds        = "index out of bounds"
-	InvalidAllocAmount = "invalid `allocAmount`"
-	CannotAddAmount    = "cannot add this amount"
+	OutOfBounds           = "index out of bounds"
+	InvalidAllocAmount    = "invalid `allocAmount`"
+	CannotAddAmount       = "cannot add this amount"
+	InvalidGrowthStrategy = "growth strategy did not increase capacity"
 )
 
 /*
@@ -22,6 +22,70 @@ type Vector[T any] struct {
 	len         int
 	capacity    int
 	allocAmount int
+	growth      GrowthStrategy
+	codec       Codec[T]
+}
+
+/*
+Determines how much capacity a Vector adds when it runs out of room.
+*/
+type GrowthStrategy interface {
+	// Returns the new total capacity to grow to, given the current capacity.
+	NextCapacity(capacity int) int
+}
+
+/*
+Grows capacity by a constant Amount each time. This is the original Vector behavior, kept for backward
+compatibility, and gives O(n) amortized PushBack for n pushes.
+*/
+type FixedGrowth struct {
+	Amount int
+}
+
+func (g FixedGrowth) NextCapacity(capacity int) int {
+	return capacity + g.Amount
+}
+
+/*
+Grows capacity by multiplying it by Factor, with a floor of Min additional capacity. This mirrors Rust's
+Vec doubling strategy and gives amortized O(1) PushBack.
+*/
+type GeometricGrowth struct {
+	Factor float64
+	Min    int
+}
+
+func (g GeometricGrowth) NextCapacity(capacity int) int {
+	grown := int(float64(capacity) * g.Factor)
+	minGrown := capacity + g.Min
+
+	if grown > minGrown {
+		return grown
+	}
+
+	return minGrown
+}
+
+/*
+A constructor option that configures a Vector at creation time. Used with FromSlice, Empty, and EmptyWithCapacity.
+*/
+type Option[T any] func(*Vector[T])
+
+/*
+Sets the GrowthStrategy a Vector uses when it needs to grow its capacity. Defaults to FixedGrowth{Amount: allocAmount}.
+*/
+func WithGrowth[T any](strategy GrowthStrategy) Option[T] {
+	return func(v *Vector[T]) {
+		v.growth = strategy
+	}
+}
+
+func applyOptions[T any](v *Vector[T], opts []Option[T]) *Vector[T] {
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
 }
 
 /*
@@ -29,13 +93,15 @@ Create a Vector from a slice with capacity len(slice).
 
 Returns an error if allocAmount <= 0.
 */
-func FromSlice[T any](slice []T, allocAmount int) *Vector[T]{
+func FromSlice[T any](slice []T, allocAmount int, opts ...Option[T]) *Vector[T] {
 	if allocAmount <= 0 {
 		panic(InvalidAllocAmount)
 	}
 
 	size := len(slice)
-	return &Vector[T]{data: slice, len: size, capacity: size, allocAmount: allocAmount}
+	v := &Vector[T]{data: slice, len: size, capacity: size, allocAmount: allocAmount, growth: FixedGrowth{Amount: allocAmount}, codec: defaultCodec[T]()}
+
+	return applyOptions(v, opts)
 }
 
 /*
@@ -43,12 +109,14 @@ Create an empty Vector with a capacity of 0.
 
 Returns an error if allocAmount <= 0.
 */
-func Empty[T any](allocAmount int) *Vector[T] {
+func Empty[T any](allocAmount int, opts ...Option[T]) *Vector[T] {
 	if allocAmount <= 0 {
 		panic(InvalidAllocAmount)
 	}
 
-	return &Vector[T]{data: []T{}, len: 0, capacity: 0, allocAmount: allocAmount}
+	v := &Vector[T]{data: []T{}, len: 0, capacity: 0, allocAmount: allocAmount, growth: FixedGrowth{Amount: allocAmount}, codec: defaultCodec[T]()}
+
+	return applyOptions(v, opts)
 }
 
 /*
@@ -56,12 +124,14 @@ Create an empty Vector with a specified capacity.
 
 Returns an error if allocAmount <= 0.
 */
-func EmptyWithCapacity[T any](capacity, allocAmount int) *Vector[T] {
+func EmptyWithCapacity[T any](capacity, allocAmount int, opts ...Option[T]) *Vector[T] {
 	if allocAmount <= 0 {
 		panic(InvalidAllocAmount)
 	}
 
-	return &Vector[T]{data: make([]T, capacity), len: 0, capacity: capacity, allocAmount: allocAmount}
+	v := &Vector[T]{data: make([]T, capacity), len: 0, capacity: capacity, allocAmount: allocAmount, growth: FixedGrowth{Amount: allocAmount}, codec: defaultCodec[T]()}
+
+	return applyOptions(v, opts)
 }
 
 /*
@@ -100,6 +170,20 @@ func (v *Vector[T]) SetAllocAmount(newVal int) error {
 	return nil
 }
 
+/*
+Get the current growth strategy.
+*/
+func (v *Vector[T]) GrowthStrategy() GrowthStrategy {
+	return v.growth
+}
+
+/*
+Set the growth strategy used when the Vector needs to grow its capacity.
+*/
+func (v *Vector[T]) SetGrowthStrategy(strategy GrowthStrategy) {
+	v.growth = strategy
+}
+
 /*
 Add new capacity to the vector. Takes O(newCapacity) time to copy the vector's elements to a larger allocation.
 
@@ -110,16 +194,44 @@ func (v *Vector[T]) AddCapacity(amount int) error {
 		return errors.New(CannotAddAmount)
 	}
 
-	v.capacity += amount
+	newCapacity := v.capacity + amount
 
-	newSlice := make([]T, v.capacity)
-	copy(newSlice, v.data)
+	newData := make([]T, newCapacity)
+	copy(newData, v.data[:v.len])
 
-	v.data = newSlice
+	v.data = newData
+	v.capacity = newCapacity
 
 	return nil
 }
 
+// nextCapacity asks the growth strategy for the next capacity to grow `from`. A strategy that fails to
+// actually grow (e.g. GeometricGrowth{Factor: 1, Min: 0}) would otherwise spin Reserve forever or
+// silently no-op AddCapacity and panic later on the write, so that case panics here instead, immediately
+// and with a clear cause.
+func (v *Vector[T]) nextCapacity(from int) int {
+	next := v.growth.NextCapacity(from)
+	if next <= from {
+		panic(InvalidGrowthStrategy)
+	}
+
+	return next
+}
+
+// ensureCapacity grows the Vector to exactly `required` capacity in a single allocation if it is not
+// already big enough. Used by the bulk operations so they don't pay for repeated growth-strategy steps.
+func (v *Vector[T]) ensureCapacity(required int) {
+	if v.capacity >= required {
+		return
+	}
+
+	newData := make([]T, required)
+	copy(newData, v.data[:v.len])
+
+	v.data = newData
+	v.capacity = required
+}
+
 /*
 Appends an item to the end of the Vector. Runs in O(1) time if there is no allocation. Otherwise takes O(newCapacity) time
 to copy values to a bigger allocation.
@@ -130,7 +242,7 @@ func (v *Vector[T]) PushBack(val T) bool {
 	allocated := v.len == v.capacity
 
 	if allocated {
-		_ = v.AddCapacity(v.allocAmount)
+		_ = v.AddCapacity(v.nextCapacity(v.capacity) - v.capacity)
 	}
 
 	v.data[v.len] = val
@@ -166,7 +278,7 @@ func (v *Vector[T]) Insert(index int, val T) (bool, error) {
 	allocated := v.len == v.capacity
 
 	if allocated {
-		_ = v.AddCapacity(v.allocAmount)
+		_ = v.AddCapacity(v.nextCapacity(v.capacity) - v.capacity)
 	}
 
 	v.len++
@@ -247,7 +359,7 @@ func (v *Vector[T]) Data() []T {
 }
 
 /*
-Removes the value at the specified index.
+Removes the value at the specified index. Shifts all following elements down by one. Capacity is left unchanged.
 
 Returns the removed value. Returns an error if the index is out of bounds.
 */
@@ -256,24 +368,84 @@ func (v *Vector[T]) Remove(index int) (*T, error) {
 		return nil, err
 	}
 
+	return v.RemoveUnchecked(index), nil
+}
+
+/*
+Removes the value at the specified index and returns it without checking that the index is in bounds (panics if out of bounds).
+
+Shifts all following elements down by one. Capacity is left unchanged.
+*/
+func (v *Vector[T]) RemoveUnchecked(index int) *T {
 	val := v.data[index]
 
-	v.data = slices.Delete(v.data, index, index+1)
-	v.capacity--
+	copy(v.data[index:v.len-1], v.data[index+1:v.len])
 	v.len--
 
-	return &val, nil
+	return &val
 }
 
 /*
-Removes the value at the specified index and returns it without checking that the index is in bounds (panics if out of bounds).
+Removes the last element and returns it. Runs in O(1) time. Capacity is left unchanged.
+
+Returns nil if the vector is empty.
 */
-func (v *Vector[T]) RemoveUnchecked(index int) *T {
+func (v *Vector[T]) Pop() *T {
+	if v.len == 0 {
+		return nil
+	}
+
+	v.len--
+	val := v.data[v.len]
+
+	return &val
+}
+
+/*
+Shortens the vector to newLen elements. Does nothing if newLen >= v.Len(). Capacity is left unchanged.
+*/
+func (v *Vector[T]) Truncate(newLen int) {
+	if newLen >= v.len {
+		return
+	}
+
+	if newLen < 0 {
+		newLen = 0
+	}
+
+	v.len = newLen
+}
+
+/*
+Removes all elements from the vector, setting its length to 0. Capacity is left unchanged.
+*/
+func (v *Vector[T]) Clear() {
+	v.len = 0
+}
+
+/*
+Removes the value at the specified index by moving the last element into its place. Runs in O(1) time,
+but does not preserve ordering. Capacity is left unchanged.
+
+Returns the removed value. Returns an error if the index is out of bounds.
+*/
+func (v *Vector[T]) SwapRemove(index int) (*T, error) {
+	if err := v.boundsCheck(index); err != nil {
+		return nil, err
+	}
+
+	return v.SwapRemoveUnchecked(index), nil
+}
+
+/*
+Removes the value at the specified index by moving the last element into its place without checking that the
+index is in bounds (panics if out of bounds). Does not preserve ordering. Capacity is left unchanged.
+*/
+func (v *Vector[T]) SwapRemoveUnchecked(index int) *T {
 	val := v.data[index]
 
-	v.data = slices.Delete(v.data, index, index+1)
-	v.capacity--
 	v.len--
+	v.data[index] = v.data[v.len]
 
 	return &val
 }
@@ -0,0 +1,23 @@
+package vector
+
+import "testing"
+
+// BenchmarkPushBackFixedGrowth demonstrates the O(n) amortized cost of PushBack under the original
+// fixed-amount growth strategy: every allocAmount pushes triggers a full O(n) copy.
+func BenchmarkPushBackFixedGrowth(b *testing.B) {
+	v := Empty[int](16)
+
+	for i := 0; i < b.N; i++ {
+		v.PushBack(i)
+	}
+}
+
+// BenchmarkPushBackGeometricGrowth demonstrates amortized O(1) PushBack: capacity doubles, so the
+// number of reallocations is O(log n) instead of O(n).
+func BenchmarkPushBackGeometricGrowth(b *testing.B) {
+	v := Empty[int](16, WithGrowth[int](GeometricGrowth{Factor: 2, Min: 16}))
+
+	for i := 0; i < b.N; i++ {
+		v.PushBack(i)
+	}
+}
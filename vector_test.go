@@ -18,7 +18,40 @@ func TestPushRemove(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 1, *val)
 
-	assert.Equal(t, []int{4, 2, 3, 0}, v.data)
+	assert.Equal(t, 3, v.len)
+	assert.Equal(t, 5, v.capacity)
+	assert.Equal(t, []int{4, 2, 3}, v.Data())
+}
+
+func TestPopTruncateClearSwapRemove(t *testing.T) {
+	v := FromSlice([]int{1, 2, 3, 4, 5}, 5)
+
+	val := v.Pop()
+	assert.Equal(t, 5, *val)
+	assert.Equal(t, 4, v.len)
+	assert.Equal(t, 5, v.capacity)
+
+	v.Truncate(2)
+	assert.Equal(t, []int{1, 2}, v.Data())
+	assert.Equal(t, 5, v.capacity)
+
+	// truncating past the current length is a no-op
+	v.Truncate(10)
+	assert.Equal(t, 2, v.len)
+
+	val, err := v.SwapRemove(0)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, *val)
+	assert.Equal(t, []int{2}, v.Data())
+
+	_, err = v.SwapRemove(5)
+	assert.EqualError(t, err, OutOfBounds)
+
+	v.Clear()
+	assert.Equal(t, 0, v.Len())
+	assert.Equal(t, 5, v.capacity)
+
+	assert.Nil(t, v.Pop())
 }
 
 func TestIndexFuncs(t *testing.T) {
@@ -66,6 +99,43 @@ func TestInsert(t *testing.T) {
 	assert.EqualError(t, err, OutOfBounds)
 }
 
+func TestGrowthStrategy(t *testing.T) {
+	v := Empty[int](5)
+	assert.Equal(t, FixedGrowth{Amount: 5}, v.GrowthStrategy())
+
+	v.PushBack(1)
+	assert.Equal(t, 5, v.Capacity())
+
+	v.SetGrowthStrategy(GeometricGrowth{Factor: 2, Min: 1})
+
+	for i := 0; i < 5; i++ {
+		v.PushBack(i)
+	}
+	assert.Equal(t, 10, v.Capacity())
+
+	g := GeometricGrowth{Factor: 2, Min: 4}
+	assert.Equal(t, 4, g.NextCapacity(0))
+	assert.Equal(t, 20, g.NextCapacity(10))
+	assert.Equal(t, 7, g.NextCapacity(3))
+}
+
+func TestGrowthStrategyThatDoesNotGrowPanics(t *testing.T) {
+	v := Empty[int](5)
+	v.SetGrowthStrategy(GeometricGrowth{Factor: 1, Min: 0})
+
+	assert.PanicsWithValue(t, InvalidGrowthStrategy, func() {
+		v.PushBack(1)
+	})
+}
+
+func TestWithGrowthOption(t *testing.T) {
+	v := Empty[int](5, WithGrowth[int](GeometricGrowth{Factor: 2, Min: 8}))
+	assert.Equal(t, GeometricGrowth{Factor: 2, Min: 8}, v.GrowthStrategy())
+
+	v.PushBack(1)
+	assert.Equal(t, 8, v.Capacity())
+}
+
 func TestExtraneousMutate(t *testing.T) {
 	v := FromSlice([]int{1, 2, 3}, 5)
 